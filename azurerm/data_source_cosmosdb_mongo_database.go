@@ -0,0 +1,101 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmCosmosDbMongoDatabase() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbMongoDatabaseRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"throughput": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"autoscale_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbMongoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+
+	resp, err := client.GetMongoDBDatabase(ctx, resourceGroup, account, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Cosmos Mongo Database %q (Account %q / Resource Group %q) was not found", name, account, resourceGroup)
+		}
+
+		return fmt.Errorf("Error reading Cosmos Mongo Database %q (Account %q): %+v", name, account, err)
+	}
+
+	id, err := azure.CosmosGetIDFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ID for Cosmos Mongo Database %q (Account %q): %+v", name, account, err)
+	}
+	d.SetId(id)
+
+	if props := resp.MongoDBDatabaseProperties; props != nil {
+		_ = d.Set("name", props.ID)
+	}
+	_ = d.Set("resource_group_name", resourceGroup)
+	_ = d.Set("account_name", account)
+
+	throughputResp, err := client.GetMongoDBDatabaseThroughput(ctx, resourceGroup, account, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(throughputResp.Response) {
+			return fmt.Errorf("Error reading Throughput on Cosmos Mongo Database %q (Account %q): %+v", name, account, err)
+		}
+	} else if props := throughputResp.ThroughputSettingsGetProperties; props != nil && props.Resource != nil {
+		if throughput := props.Resource.Throughput; throughput != nil {
+			_ = d.Set("throughput", int(*throughput))
+		}
+
+		if err := d.Set("autoscale_settings", flattenCosmosMongoDatabaseAutoscaleSettings(props.Resource.AutoscaleSettings)); err != nil {
+			return fmt.Errorf("Error setting `autoscale_settings`: %+v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMCosmosDbMongoDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_mongo_database", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMCosmosDbMongoDatabase_basic(data, 700),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctest-mongodb-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "throughput", "700"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMCosmosDbMongoDatabase_autoscale(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_mongo_database", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMCosmosDbMongoDatabase_autoscale(data, 4000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctest-mongodb-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "autoscale_settings.0.max_throughput", "4000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMCosmosDbMongoDatabase_basic(data acceptance.TestData, throughput int) string {
+	template := testAccAzureRMCosmosDbMongoDatabase_throughput(data, throughput)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_mongo_database" "test" {
+  name                = azurerm_cosmosdb_mongo_database.test.name
+  resource_group_name = azurerm_cosmosdb_mongo_database.test.resource_group_name
+  account_name        = azurerm_cosmosdb_mongo_database.test.account_name
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMCosmosDbMongoDatabase_autoscale(data acceptance.TestData, maxThroughput int) string {
+	template := testAccAzureRMCosmosDbMongoDatabase_autoscale(data, maxThroughput)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_mongo_database" "test" {
+  name                = azurerm_cosmosdb_mongo_database.test.name
+  resource_group_name = azurerm_cosmosdb_mongo_database.test.resource_group_name
+  account_name        = azurerm_cosmosdb_mongo_database.test.account_name
+}
+`, template)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,6 +19,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// cosmosDbAutoscaleSettings is the JSON value passed in the `autoscaleSettings`
+// create option when a database is created with autoscale throughput enabled.
+type cosmosDbAutoscaleSettings struct {
+	MaxThroughput int32 `json:"maxThroughput"`
+}
+
 func resourceArmCosmosDbMongoDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmCosmosDbMongoDatabaseCreateUpdate,
@@ -29,6 +36,8 @@ func resourceArmCosmosDbMongoDatabase() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmCosmosDbMongoDatabaseCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -54,11 +63,120 @@ func resourceArmCosmosDbMongoDatabase() *schema.Resource {
 			},
 
 			"throughput": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      nil,
-				ValidateFunc: validate.CosmosThroughput,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Default:       nil,
+				ValidateFunc:  validate.CosmosThroughput,
+				ConflictsWith: []string{"autoscale_settings"},
 			},
+
+			"autoscale_settings": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"throughput"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_throughput": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4000,
+							ValidateFunc: validateCosmosDbAutoscaleMaxThroughput,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateCosmosDbAutoscaleMaxThroughput ensures the supplied value is one of
+// the tier multiples Cosmos DB autoscale accepts - 1,000 RU/s increments
+// starting at a floor of 4,000 RU/s.
+func validateCosmosDbAutoscaleMaxThroughput(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return warnings, errors
+	}
+
+	if v < 4000 || v%1000 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a multiple of 1,000 no less than 4,000, got %d", k, v))
+	}
+
+	return warnings, errors
+}
+
+// resourceArmCosmosDbMongoDatabaseCustomizeDiff forces recreation of the database
+// when throughput (manual or autoscale) is being added to an existing database
+// that was created without any throughput configured. Cosmos rejects that
+// transition with a 404 on the underlying PUT, so the diff needs to surface it
+// as a replace rather than letting the apply fail after the database-level
+// PUT has already succeeded.
+func resourceArmCosmosDbMongoDatabaseCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldThroughput, newThroughput := d.GetChange("throughput")
+	oldAutoscale, newAutoscale := d.GetChange("autoscale_settings")
+
+	throughputBeingAdded := oldThroughput.(int) == 0 && newThroughput.(int) > 0
+	autoscaleBeingAdded := len(oldAutoscale.([]interface{})) == 0 && len(newAutoscale.([]interface{})) > 0
+
+	if !throughputBeingAdded && !autoscaleBeingAdded {
+		return nil
+	}
+
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := azure.ParseCosmosDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetMongoDBDatabaseThroughput(ctx, id.ResourceGroup, id.Account, id.Database)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error checking for existing Throughput on Cosmos Mongo Database %q (Account %q): %+v", id.Database, id.Account, err)
+		}
+
+		if throughputBeingAdded {
+			if err := d.ForceNew("throughput"); err != nil {
+				return err
+			}
+		}
+		if autoscaleBeingAdded {
+			if err := d.ForceNew("autoscale_settings"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandCosmosMongoDatabaseAutoscaleSettings(d *schema.ResourceData) *documentdb.AutoscaleSettings {
+	input := d.Get("autoscale_settings").([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &documentdb.AutoscaleSettings{
+		MaxThroughput: utils.Int32(int32(v["max_throughput"].(int))),
+	}
+}
+
+func flattenCosmosMongoDatabaseAutoscaleSettings(settings *documentdb.AutoscaleSettings) []interface{} {
+	if settings == nil || settings.MaxThroughput == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_throughput": int(*settings.MaxThroughput),
 		},
 	}
 }
@@ -73,6 +191,8 @@ func resourceArmCosmosDbMongoDatabaseCreateUpdate(d *schema.ResourceData, meta i
 	account := d.Get("account_name").(string)
 	throughput := d.Get("throughput").(int)
 	dbHasThroughputConfigured := throughput > 0
+	autoscaleSettings := expandCosmosMongoDatabaseAutoscaleSettings(d)
+	dbHasAutoscaleSettingsConfigured := autoscaleSettings != nil
 
 	createUpdateOptions := map[string]*string{}
 
@@ -91,8 +211,16 @@ func resourceArmCosmosDbMongoDatabaseCreateUpdate(d *schema.ResourceData, meta i
 
 				return tf.ImportAsExistsError("azurerm_cosmosdb_mongo_database", id)
 			}
-		} else if dbHasThroughputConfigured {
+		}
+
+		if dbHasThroughputConfigured {
 			createUpdateOptions["throughput"] = utils.String(strconv.Itoa(throughput))
+		} else if dbHasAutoscaleSettingsConfigured {
+			autoscaleOption, err := json.Marshal(cosmosDbAutoscaleSettings{MaxThroughput: *autoscaleSettings.MaxThroughput})
+			if err != nil {
+				return fmt.Errorf("Error marshalling autoscale settings for Cosmos Mongo Database %s (Account %s): %+v", name, account, err)
+			}
+			createUpdateOptions["autoscaleSettings"] = utils.String(string(autoscaleOption))
 		}
 	}
 
@@ -114,18 +242,44 @@ func resourceArmCosmosDbMongoDatabaseCreateUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("Error waiting on create/update future for Cosmos Mongo Database %s (Account %s): %+v", name, account, err)
 	}
 
-	if dbHasThroughputConfigured && !d.IsNewResource() {
+	if !d.IsNewResource() && (dbHasThroughputConfigured || dbHasAutoscaleSettingsConfigured) && (d.HasChange("throughput") || d.HasChange("autoscale_settings")) {
+		oldAutoscaleRaw, _ := d.GetChange("autoscale_settings")
+		wasAutoscale := len(oldAutoscaleRaw.([]interface{})) > 0
+
+		if dbHasAutoscaleSettingsConfigured && !wasAutoscale {
+			migrateFuture, err := client.MigrateMongoDBDatabaseToAutoscale(ctx, resourceGroup, account, name)
+			if err != nil {
+				return fmt.Errorf("Error migrating Cosmos Mongo Database %s (Account %s) to autoscale: %+v", name, account, err)
+			}
+			if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting on migrate to autoscale future for Cosmos Mongo Database %s (Account %s): %+v", name, account, err)
+			}
+		} else if dbHasThroughputConfigured && wasAutoscale {
+			migrateFuture, err := client.MigrateMongoDBDatabaseToManualThroughput(ctx, resourceGroup, account, name)
+			if err != nil {
+				return fmt.Errorf("Error migrating Cosmos Mongo Database %s (Account %s) to manual throughput: %+v", name, account, err)
+			}
+			if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting on migrate to manual throughput future for Cosmos Mongo Database %s (Account %s): %+v", name, account, err)
+			}
+		}
+
 		throughputParameters := documentdb.ThroughputUpdateParameters{
 			ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
-				Resource: &documentdb.ThroughputResource{
-					Throughput: utils.Int32(int32(throughput)),
-				},
+				Resource: &documentdb.ThroughputResource{},
 			},
 		}
 
+		if dbHasAutoscaleSettingsConfigured {
+			throughputParameters.ThroughputUpdateProperties.Resource.AutoscaleSettings = autoscaleSettings
+		} else if dbHasThroughputConfigured {
+			throughputParameters.ThroughputUpdateProperties.Resource.Throughput = utils.Int32(int32(throughput))
+		}
+
 		throughputFuture, err := client.UpdateMongoDBDatabaseThroughput(ctx, resourceGroup, account, name, throughputParameters)
 		if err != nil {
 			_ = d.Set("throughput", nil)
+			_ = d.Set("autoscale_settings", nil)
 			if throughputFuture.Response().StatusCode == http.StatusNotFound {
 				return fmt.Errorf("Error setting Throughput for Cosmos MongoDB Database %s (Account %s): %+v - "+
 					"If the database has not been created with an initial throughput, you cannot configure it later.", name, account, err)
@@ -183,11 +337,21 @@ func resourceArmCosmosDbMongoDatabaseRead(d *schema.ResourceData, meta interface
 	if err != nil {
 		if !utils.ResponseWasNotFound(throughputResp.Response) {
 			_ = d.Set("throughput", nil)
+			_ = d.Set("autoscale_settings", nil)
 			return fmt.Errorf("Error reading Throughput on Cosmos Mongo Database %s (Account %s): %+v", id.Database, id.Account, err)
 		}
 	} else {
-		if throughput := throughputResp.Throughput; throughput != nil {
-			_ = d.Set("throughput", int(*throughput))
+		_ = d.Set("throughput", nil)
+		_ = d.Set("autoscale_settings", nil)
+
+		if props := throughputResp.ThroughputSettingsGetProperties; props != nil && props.Resource != nil {
+			if throughput := props.Resource.Throughput; throughput != nil {
+				_ = d.Set("throughput", int(*throughput))
+			}
+
+			if err := d.Set("autoscale_settings", flattenCosmosMongoDatabaseAutoscaleSettings(props.Resource.AutoscaleSettings)); err != nil {
+				return fmt.Errorf("Error setting `autoscale_settings`: %+v", err)
+			}
 		}
 	}
 
@@ -0,0 +1,292 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCosmosDbMongoDatabase_throughputCannotBeAddedLater(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_database", "test")
+	var firstID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				// create the database without any throughput configured
+				Config: testAccAzureRMCosmosDbMongoDatabase_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoDatabaseExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoDatabaseCaptureID(data.ResourceName, &firstID),
+				),
+			},
+			{
+				// adding throughput to that database must replace it rather than fail the apply,
+				// and the replacement create must actually land the configured throughput
+				Config: testAccAzureRMCosmosDbMongoDatabase_throughput(data, 700),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoDatabaseExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoDatabaseWasRecreated(data.ResourceName, &firstID),
+					resource.TestCheckResourceAttr(data.ResourceName, "throughput", "700"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbMongoDatabase_migrateThroughputMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_database", "test")
+	var firstID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				// create with manual throughput
+				Config: testAccAzureRMCosmosDbMongoDatabase_throughput(data, 700),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoDatabaseExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoDatabaseCaptureID(data.ResourceName, &firstID),
+					resource.TestCheckResourceAttr(data.ResourceName, "throughput", "700"),
+				),
+			},
+			{
+				// migrate manual -> autoscale in place, without replacing the database
+				Config: testAccAzureRMCosmosDbMongoDatabase_autoscale(data, 4000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoDatabaseExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoDatabaseWasNotRecreated(data.ResourceName, &firstID),
+					resource.TestCheckResourceAttr(data.ResourceName, "autoscale_settings.0.max_throughput", "4000"),
+				),
+			},
+			{
+				// migrate autoscale -> manual back again, still without replacing the database
+				Config: testAccAzureRMCosmosDbMongoDatabase_throughput(data, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoDatabaseExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoDatabaseWasNotRecreated(data.ResourceName, &firstID),
+					resource.TestCheckResourceAttr(data.ResourceName, "throughput", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCosmosDbMongoDatabaseCaptureID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbMongoDatabaseWasRecreated(resourceName string, originalID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == *originalID {
+			return fmt.Errorf("Bad: Cosmos Mongo Database %s was expected to be replaced when throughput was added, but kept the same ID", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbMongoDatabaseDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
+	ctx := acceptance.AzureProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_mongo_database" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		account := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetMongoDBDatabase(ctx, resourceGroup, account, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Get on cosmosDBMongoDatabaseClient: %+v", err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Cosmos Mongo Database %s (Account %s) still exists", name, account)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMCosmosDbMongoDatabaseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
+		ctx := acceptance.AzureProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		account := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetMongoDBDatabase(ctx, resourceGroup, account, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on cosmosDBMongoDatabaseClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Cosmos Mongo Database %s (Account %s) does not exist", name, account)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbMongoDatabaseWasNotRecreated(resourceName string, originalID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID != *originalID {
+			return fmt.Errorf("Bad: Cosmos Mongo Database %s was expected to migrate throughput mode in place, but was replaced", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMCosmosDbMongoDatabase_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "MongoDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-mongodb-%d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMCosmosDbMongoDatabase_throughput(data acceptance.TestData, throughput int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "MongoDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-mongodb-%d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  throughput          = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, throughput)
+}
+
+func testAccAzureRMCosmosDbMongoDatabase_autoscale(data acceptance.TestData, maxThroughput int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "MongoDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-mongodb-%d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+
+  autoscale_settings {
+    max_throughput = %d
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, maxThroughput)
+}